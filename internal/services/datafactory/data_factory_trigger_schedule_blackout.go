@@ -0,0 +1,168 @@
+package datafactory
+
+import (
+	"errors"
+	"time"
+)
+
+// blackoutWindowLookaheadLimit bounds how far a recurring blackout window is projected forward
+// when searching for the occurrence that contains (or next contains) a given instant, so a
+// misconfigured recurrence can't spin the provider forever.
+const blackoutWindowLookaheadLimit = 2000
+
+// dataFactoryBlackoutWindowsContain reports whether `now` falls inside any configured
+// `blackout_window`, and if so which `action` that window declares.
+func dataFactoryBlackoutWindowsContain(input []interface{}, now time.Time) (bool, string) {
+	for _, v := range input {
+		value := v.(map[string]interface{})
+
+		start, err := time.Parse(time.RFC3339, value["start_time"].(string))
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.RFC3339, value["end_time"].(string))
+		if err != nil || !end.After(start) {
+			continue
+		}
+		action, _ := value["action"].(string)
+
+		occStart, found := dataFactoryBlackoutOccurrenceAt(value, start, end, now)
+		if !found {
+			continue
+		}
+		duration := end.Sub(start)
+		if !now.Before(occStart) && now.Before(occStart.Add(duration)) {
+			return true, action
+		}
+	}
+
+	return false, ""
+}
+
+// nextDataFactoryBlackoutWindowTransition returns the earliest upcoming boundary (a window
+// opening or closing) across every configured `blackout_window`, so drift detection has something
+// concrete to re-reconcile against on the next apply.
+func nextDataFactoryBlackoutWindowTransition(input []interface{}, now time.Time) *time.Time {
+	var earliest *time.Time
+	consider := func(t time.Time) {
+		if t.Before(now) {
+			return
+		}
+		if earliest == nil || t.Before(*earliest) {
+			earliest = &t
+		}
+	}
+
+	for _, v := range input {
+		value := v.(map[string]interface{})
+
+		start, err := time.Parse(time.RFC3339, value["start_time"].(string))
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.RFC3339, value["end_time"].(string))
+		if err != nil || !end.After(start) {
+			continue
+		}
+		duration := end.Sub(start)
+
+		occStart, found := dataFactoryBlackoutOccurrenceAt(value, start, end, now)
+		if !found {
+			continue
+		}
+
+		consider(occStart)
+		consider(occStart.Add(duration))
+	}
+
+	return earliest
+}
+
+// dataFactoryBlackoutOccurrenceAt walks the window's recurrence (if any) forward from its anchor
+// `start`, returning the start time of the occurrence that contains `now`, or - if none contains
+// it yet - the next occurrence at or after `now`. The second return value is false once the search
+// exceeds blackoutWindowLookaheadLimit iterations without finding one. A window without a
+// recurrence always returns its single occurrence's `start` and true, even once it has closed.
+func dataFactoryBlackoutOccurrenceAt(value map[string]interface{}, start, end, now time.Time) (time.Time, bool) {
+	duration := end.Sub(start)
+
+	recurrence, _ := value["recurrence"].([]interface{})
+	if len(recurrence) == 0 || recurrence[0] == nil {
+		return start, true
+	}
+	rec := recurrence[0].(map[string]interface{})
+
+	frequency, _ := rec["frequency"].(string)
+	interval := 1
+	if v, ok := rec["interval"].(int); ok && v > 0 {
+		interval = v
+	}
+	daysOfWeek := make(map[time.Weekday]bool)
+	if v, ok := rec["days_of_week"].([]interface{}); ok {
+		for _, d := range v {
+			if wd, err := parseWeekdayName(d.(string)); err == nil {
+				daysOfWeek[wd] = true
+			}
+		}
+	}
+
+	occStart := start
+	for i := 0; i < blackoutWindowLookaheadLimit; i++ {
+		if blackoutOccurrenceMatches(occStart, start, frequency, interval, daysOfWeek) {
+			if !now.Before(occStart.Add(duration)) {
+				// this occurrence has already closed; keep looking for the next one
+			} else {
+				return occStart, true
+			}
+		}
+		occStart = occStart.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+func blackoutOccurrenceMatches(candidate, anchor time.Time, frequency string, interval int, daysOfWeek map[time.Weekday]bool) bool {
+	switch frequency {
+	case "Daily":
+		days := int(candidate.Sub(anchor).Hours() / 24)
+		return days >= 0 && days%interval == 0
+	case "Weekly":
+		if len(daysOfWeek) > 0 && !daysOfWeek[candidate.Weekday()] {
+			return false
+		}
+		if len(daysOfWeek) == 0 && candidate.Weekday() != anchor.Weekday() {
+			return false
+		}
+		weeks := int(candidate.Sub(anchor).Hours() / 24 / 7)
+		return weeks >= 0 && weeks%interval == 0
+	case "Monthly":
+		if candidate.Day() != anchor.Day() {
+			return false
+		}
+		months := (candidate.Year()-anchor.Year())*12 + int(candidate.Month()) - int(anchor.Month())
+		return months >= 0 && months%interval == 0
+	default:
+		return candidate.Equal(anchor)
+	}
+}
+
+func parseWeekdayName(name string) (time.Weekday, error) {
+	switch name {
+	case "Sunday":
+		return time.Sunday, nil
+	case "Monday":
+		return time.Monday, nil
+	case "Tuesday":
+		return time.Tuesday, nil
+	case "Wednesday":
+		return time.Wednesday, nil
+	case "Thursday":
+		return time.Thursday, nil
+	case "Friday":
+		return time.Friday, nil
+	case "Saturday":
+		return time.Saturday, nil
+	default:
+		return time.Sunday, errors.New("invalid day of week")
+	}
+}
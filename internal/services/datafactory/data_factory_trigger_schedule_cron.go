@@ -0,0 +1,182 @@
+package datafactory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// cronDaysOfWeek maps the cron day-of-week field (0-7, both 0 and 7 meaning Sunday) onto the
+// `datafactory.DaysOfWeek` values accepted by `RecurrenceSchedule.WeekDays`.
+var cronDaysOfWeek = []datafactory.DaysOfWeek{
+	datafactory.DaysOfWeekSunday,
+	datafactory.DaysOfWeekMonday,
+	datafactory.DaysOfWeekTuesday,
+	datafactory.DaysOfWeekWednesday,
+	datafactory.DaysOfWeekThursday,
+	datafactory.DaysOfWeekFriday,
+	datafactory.DaysOfWeekSaturday,
+	datafactory.DaysOfWeekSunday,
+}
+
+func validateDataFactoryTriggerScheduleTimeZone(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := time.LoadLocation(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid IANA time zone: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+// expandDataFactoryTriggerScheduleCronExpression parses a standard 5-field (minute hour
+// day-of-month month day-of-week) or 6-field (second minute hour day-of-month month day-of-week)
+// cron expression into the equivalent `RecurrenceSchedule`. The leading seconds field, when
+// present, is accepted for compatibility with Quartz-style expressions but discarded, since the
+// Data Factory schedule trigger has no sub-minute granularity.
+//
+// The Data Factory schedule trigger has no month-of-year restriction, so a month field other than
+// `*` is rejected rather than silently ignored.
+func expandDataFactoryTriggerScheduleCronExpression(cron string) (*datafactory.RecurrenceSchedule, error) {
+	fields := strings.Fields(cron)
+	switch len(fields) {
+	case 5:
+		// minute hour dom month dow
+	case 6:
+		fields = fields[1:] // drop the leading seconds field
+	default:
+		return nil, fmt.Errorf("expected a 5- or 6-field cron expression, got %d fields in %q", len(fields), cron)
+	}
+
+	minuteField, hourField, domField, monthField, dowField := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	if monthField != "*" {
+		return nil, fmt.Errorf("the `month` field of a cron expression must be `*`: the Data Factory schedule trigger does not support restricting by month of year")
+	}
+
+	minutes, err := parseCronField(minuteField, 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `minute` field: %+v", err)
+	}
+
+	hours, err := parseCronField(hourField, 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `hour` field: %+v", err)
+	}
+
+	schedule := datafactory.RecurrenceSchedule{}
+	if len(minutes) > 0 && minuteField != "*" {
+		schedule.Minutes = utils.ExpandInt32Slice(intsToInterfaces(minutes))
+	}
+	if len(hours) > 0 && hourField != "*" {
+		schedule.Hours = utils.ExpandInt32Slice(intsToInterfaces(hours))
+	}
+
+	if domField != "*" {
+		days, err := parseCronField(domField, 1, 31)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `day-of-month` field: %+v", err)
+		}
+		schedule.MonthDays = utils.ExpandInt32Slice(intsToInterfaces(days))
+	}
+
+	if dowField != "*" {
+		days, err := parseCronField(dowField, 0, 7)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `day-of-week` field: %+v", err)
+		}
+		weekDays := make([]datafactory.DaysOfWeek, 0)
+		seen := make(map[datafactory.DaysOfWeek]bool)
+		for _, d := range days {
+			day := cronDaysOfWeek[d]
+			if !seen[day] {
+				seen[day] = true
+				weekDays = append(weekDays, day)
+			}
+		}
+		schedule.WeekDays = &weekDays
+	}
+
+	return &schedule, nil
+}
+
+// parseCronField expands a single cron field - `*`, `*/step`, `a-b`, `a-b/step`, a bare value, or
+// a comma-separated list of any of the above - into the sorted, de-duplicated list of ints it
+// represents within [min, max].
+func parseCronField(field string, min, max int) ([]int, error) {
+	seen := make(map[int]bool)
+	values := make([]int, 0)
+
+	addValue := func(v int) error {
+		if v < min || v > max {
+			return fmt.Errorf("value %d out of range [%d, %d]", v, min, max)
+		}
+		if !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+		return nil
+	}
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart := part
+		step := 1
+		if idx := strings.Index(part, "/"); idx != -1 {
+			rangePart = part[:idx]
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			step = s
+		}
+
+		var lower, upper int
+		switch {
+		case rangePart == "*":
+			lower, upper = min, max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			l, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid range start in %q", part)
+			}
+			u, err := strconv.Atoi(bounds[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid range end in %q", part)
+			}
+			lower, upper = l, u
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			lower, upper = v, v
+		}
+
+		for v := lower; v <= upper; v += step {
+			if err := addValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Ints(values)
+	return values, nil
+}
+
+func intsToInterfaces(input []int) []interface{} {
+	output := make([]interface{}, 0, len(input))
+	for _, v := range input {
+		output = append(output, v)
+	}
+	return output
+}
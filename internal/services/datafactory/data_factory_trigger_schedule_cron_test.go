@@ -0,0 +1,209 @@
+package datafactory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+)
+
+func TestParseCronField(t *testing.T) {
+	testCases := []struct {
+		name    string
+		field   string
+		min     int
+		max     int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:  "wildcard",
+			field: "*",
+			min:   0,
+			max:   3,
+			want:  []int{0, 1, 2, 3},
+		},
+		{
+			name:  "single value",
+			field: "5",
+			min:   0,
+			max:   59,
+			want:  []int{5},
+		},
+		{
+			name:  "range",
+			field: "1-5",
+			min:   0,
+			max:   59,
+			want:  []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:  "step",
+			field: "*/15",
+			min:   0,
+			max:   59,
+			want:  []int{0, 15, 30, 45},
+		},
+		{
+			name:  "range with step",
+			field: "0-20/5",
+			min:   0,
+			max:   59,
+			want:  []int{0, 5, 10, 15, 20},
+		},
+		{
+			name:  "comma list",
+			field: "1,3,5",
+			min:   0,
+			max:   59,
+			want:  []int{1, 3, 5},
+		},
+		{
+			name:  "comma list with overlapping range de-duplicates",
+			field: "1-3,2-4",
+			min:   0,
+			max:   59,
+			want:  []int{1, 2, 3, 4},
+		},
+		{
+			name:    "out of range value is rejected",
+			field:   "99",
+			min:     0,
+			max:     59,
+			wantErr: true,
+		},
+		{
+			name:    "range running past max is rejected",
+			field:   "55-65",
+			min:     0,
+			max:     59,
+			wantErr: true,
+		},
+		{
+			name:    "garbage value is rejected",
+			field:   "not-a-number",
+			min:     0,
+			max:     59,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseCronField(tc.field, tc.min, tc.max)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseCronField(%q) expected an error, got none", tc.field)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCronField(%q) returned unexpected error: %+v", tc.field, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("parseCronField(%q) = %v, want %v", tc.field, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExpandDataFactoryTriggerScheduleCronExpression(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cron          string
+		wantMinutes   []int32
+		wantHours     []int32
+		wantMonthDays []int32
+		wantWeekDays  []datafactory.DaysOfWeek
+		wantErr       bool
+	}{
+		{
+			name:        "5-field every 15 minutes",
+			cron:        "*/15 * * * *",
+			wantMinutes: []int32{0, 15, 30, 45},
+		},
+		{
+			name:        "6-field drops the leading seconds field",
+			cron:        "30 */15 * * * *",
+			wantMinutes: []int32{0, 15, 30, 45},
+			wantHours:   nil, // hours is `*`, so it's left unset
+		},
+		{
+			name:         "day-of-week 0 and 7 both mean Sunday",
+			cron:         "0 0 * * 0,7",
+			wantMinutes:  []int32{0},
+			wantHours:    []int32{0},
+			wantWeekDays: []datafactory.DaysOfWeek{datafactory.DaysOfWeekSunday},
+		},
+		{
+			name:          "day-of-month list",
+			cron:          "0 9 1,15 * *",
+			wantMinutes:   []int32{0},
+			wantHours:     []int32{9},
+			wantMonthDays: []int32{1, 15},
+		},
+		{
+			name:    "unsupported month restriction is rejected",
+			cron:    "0 0 * 6 *",
+			wantErr: true,
+		},
+		{
+			name:    "wrong field count is rejected",
+			cron:    "0 0 * *",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := expandDataFactoryTriggerScheduleCronExpression(tc.cron)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expandDataFactoryTriggerScheduleCronExpression(%q) expected an error, got none", tc.cron)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expandDataFactoryTriggerScheduleCronExpression(%q) returned unexpected error: %+v", tc.cron, err)
+			}
+
+			if !int32PtrSliceEqual(got.Minutes, tc.wantMinutes) {
+				t.Errorf("Minutes = %v, want %v", int32PtrSliceValue(got.Minutes), tc.wantMinutes)
+			}
+			if !int32PtrSliceEqual(got.Hours, tc.wantHours) {
+				t.Errorf("Hours = %v, want %v", int32PtrSliceValue(got.Hours), tc.wantHours)
+			}
+			if !int32PtrSliceEqual(got.MonthDays, tc.wantMonthDays) {
+				t.Errorf("MonthDays = %v, want %v", int32PtrSliceValue(got.MonthDays), tc.wantMonthDays)
+			}
+			if tc.wantWeekDays != nil {
+				if got.WeekDays == nil || !reflect.DeepEqual(*got.WeekDays, tc.wantWeekDays) {
+					t.Errorf("WeekDays = %v, want %v", got.WeekDays, tc.wantWeekDays)
+				}
+			}
+		})
+	}
+}
+
+func int32PtrSliceValue(s *[]int32) []int32 {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func int32PtrSliceEqual(s *[]int32, want []int32) bool {
+	return reflect.DeepEqual(int32PtrSliceValue(s), want)
+}
+
+func TestValidateDataFactoryTriggerScheduleTimeZone(t *testing.T) {
+	if _, errs := validateDataFactoryTriggerScheduleTimeZone("UTC", "time_zone"); len(errs) != 0 {
+		t.Fatalf("expected %q to be a valid time zone, got errors: %+v", "UTC", errs)
+	}
+	if _, errs := validateDataFactoryTriggerScheduleTimeZone("America/New_York", "time_zone"); len(errs) != 0 {
+		t.Fatalf("expected %q to be a valid time zone, got errors: %+v", "America/New_York", errs)
+	}
+	if _, errs := validateDataFactoryTriggerScheduleTimeZone("Not/AZone", "time_zone"); len(errs) == 0 {
+		t.Fatalf("expected %q to be rejected as an invalid time zone", "Not/AZone")
+	}
+}
@@ -1,6 +1,7 @@
 package datafactory
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -38,6 +39,13 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+			if len(diff.Get("pipeline").([]interface{})) == 0 && diff.Get("pipeline_name").(string) == "" {
+				return fmt.Errorf("one of `pipeline` or `pipeline_name` must be specified")
+			}
+			return nil
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -77,10 +85,11 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 			},
 
 			"schedule": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				MaxItems:      1,
+				ConflictsWith: []string{"cron_expression"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"days_of_month": {
@@ -150,9 +159,6 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 				},
 			},
 
-			// This time can only be  represented in UTC.
-			// An issue has been filed in the SDK for the timezone attribute that doesn't seem to work
-			// https://github.com/Azure/azure-sdk-for-go/issues/6244
 			"start_time": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
@@ -161,9 +167,6 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 				ValidateFunc:     validation.IsRFC3339Time, // times in the past just start immediately
 			},
 
-			// This time can only be  represented in UTC.
-			// An issue has been filed in the SDK for the timezone attribute that doesn't seem to work
-			// https://github.com/Azure/azure-sdk-for-go/issues/6244
 			"end_time": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
@@ -171,6 +174,26 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 				ValidateFunc:     validation.IsRFC3339Time, // times in the past just start immediately
 			},
 
+			// `start_time`/`end_time` are always read back in this zone rather than UTC, so plans
+			// stay stable once a non-UTC zone is in use.
+			"time_zone": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateDataFactoryTriggerScheduleTimeZone,
+			},
+
+			// Expands to the equivalent `schedule` block, so it can't be used alongside one.
+			// The API has no concept of a cron expression, which means it isn't read back into
+			// state - but since it's also never set from Read, there's no spurious drift to
+			// suppress, and a genuine edit here must still produce a diff.
+			"cron_expression": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"schedule"},
+			},
+
 			"frequency": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -198,20 +221,57 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			// TODO remove in 3.0
 			"pipeline_name": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.DataFactoryPipelineAndTriggerName(),
+				Deprecated:    "`pipeline_name` is deprecated in favour of `pipeline` and will be removed in version 3.0 of the AzureRM provider",
+				ConflictsWith: []string{"pipeline"},
 			},
 
+			// TODO remove in 3.0
 			"pipeline_parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "`pipeline_parameters` is deprecated in favour of `pipeline` and will be removed in version 3.0 of the AzureRM provider",
+				ConflictsWith: []string{"pipeline"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			// The underlying API models `Pipelines` as a list, so a single trigger can fan out to
+			// several pipelines, each with its own parameters. `pipeline_name`/`pipeline_parameters`
+			// remain as deprecated shims onto a single-element list for backward compatibility.
+			// `Computed` on all three so a config that only sets one form doesn't plan to clear the
+			// other - the one absent from config just adopts whatever Read populates from state.
+			"pipeline": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"pipeline_name", "pipeline_parameters"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+						},
+
+						"parameters": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"annotations": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -220,6 +280,86 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+
+			// `blackout_window` has no representation in the Data Factory trigger API - there is
+			// no server-side concept of planned-maintenance windows for a trigger. This provider
+			// can only reconcile the trigger's activated/started state towards the blackout at the
+			// moment `terraform apply` runs; it does not stand up a separate always-on controller
+			// (an Azure Function, a Logic App, or otherwise) to toggle the trigger while Terraform
+			// isn't running. Schedule `terraform apply` externally (e.g. on a timer in CI) around
+			// `next_blackout_transition` if you need the boundary enforced without a human present.
+			"blackout_window": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"start_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"end_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"recurrence": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"frequency": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Daily",
+											"Weekly",
+											"Monthly",
+										}, false),
+									},
+
+									"interval": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"days_of_week": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 7,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.IsDayOfTheWeek(false),
+										},
+									},
+								},
+							},
+						},
+
+						// `stop` is the only supported action: the trigger API can only be started or
+						// stopped, it has no concept of pausing an individual pipeline run while
+						// leaving the trigger itself active, so there's nothing else to implement here.
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "stop",
+							ValidateFunc: validation.StringInSlice([]string{
+								"stop",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"next_blackout_transition": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -258,11 +398,24 @@ func resourceDataFactoryTriggerScheduleCreateUpdate(d *pluginsdk.ResourceData, m
 		}
 	}
 
+	schedule := expandDataFactorySchedule(d.Get("schedule").([]interface{}))
+	if v, ok := d.GetOk("cron_expression"); ok {
+		schedule, err = expandDataFactoryTriggerScheduleCronExpression(v.(string))
+		if err != nil {
+			return fmt.Errorf("expanding `cron_expression`: %+v", err)
+		}
+	}
+
+	// `time_zone` defaults to "UTC" for backward compatibility with configurations written before
+	// this field existed, since the API previously only ever honoured UTC.
+	timeZone := d.Get("time_zone").(string)
+
 	props := &datafactory.ScheduleTriggerTypeProperties{
 		Recurrence: &datafactory.ScheduleTriggerRecurrence{
 			Frequency: datafactory.RecurrenceFrequency(d.Get("frequency").(string)),
 			Interval:  utils.Int32(int32(d.Get("interval").(int))),
-			Schedule:  expandDataFactorySchedule(d.Get("schedule").([]interface{})),
+			Schedule:  schedule,
+			TimeZone:  utils.String(timeZone),
 		},
 	}
 
@@ -279,20 +432,10 @@ func resourceDataFactoryTriggerScheduleCreateUpdate(d *pluginsdk.ResourceData, m
 		props.Recurrence.EndTime = &date.Time{Time: t}
 	}
 
-	reference := &datafactory.PipelineReference{
-		ReferenceName: utils.String(d.Get("pipeline_name").(string)),
-		Type:          utils.String("PipelineReference"),
-	}
-
 	scheduleProps := &datafactory.ScheduleTrigger{
 		ScheduleTriggerTypeProperties: props,
-		Pipelines: &[]datafactory.TriggerPipelineReference{
-			{
-				PipelineReference: reference,
-				Parameters:        d.Get("pipeline_parameters").(map[string]interface{}),
-			},
-		},
-		Description: utils.String(d.Get("description").(string)),
+		Pipelines:                     expandDataFactoryTriggerSchedulePipelines(d),
+		Description:                   utils.String(d.Get("description").(string)),
 	}
 
 	if v, ok := d.GetOk("annotations"); ok {
@@ -308,18 +451,75 @@ func resourceDataFactoryTriggerScheduleCreateUpdate(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if d.Get("activated").(bool) {
-		future, err := client.Start(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	blackoutWindows := d.Get("blackout_window").([]interface{})
+
+	// There's no server-side enforcement of `blackout_window` - the Data Factory trigger API has
+	// no concept of a planned-maintenance window, so this resource can only reconcile the
+	// trigger's started state against it as of the instant `terraform apply` runs. Anyone relying
+	// on this to keep a trigger stopped for the whole window needs to re-apply (e.g. on a CI
+	// schedule) around `next_blackout_transition`; nothing runs in between applies.
+	if len(blackoutWindows) > 0 {
+		log.Printf("[WARN] `blackout_window` on %s is only enforced while `terraform apply` is running - it does not stop the trigger from firing between applies", id)
+	}
+
+	wantActivated := d.Get("activated").(bool)
+	if inBlackout, _ := dataFactoryBlackoutWindowsContain(blackoutWindows, time.Now()); inBlackout {
+		wantActivated = false
+	}
+
+	// Prior to `blackout_window`, `activated=false` was a no-op on update - only `activated=true`
+	// ever called through to the API (via Start). Stopping an already-running trigger outright
+	// is scoped to `blackout_window` users opting into the new reconciliation behaviour, so a
+	// plain `activated=false` config with no blackout window keeps its pre-existing behaviour
+	// instead of suddenly issuing a Stop call it never did before.
+	if wantActivated {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s to determine its current runtime state: %+v", id, err)
+		}
+		currentlyStarted := false
+		if existingProps, ok := existing.Properties.AsScheduleTrigger(); ok && existingProps != nil {
+			currentlyStarted = existingProps.RuntimeState == datafactory.TriggerRuntimeStateStarted
+		}
+
+		if !currentlyStarted {
+			future, err := client.Start(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+			if err != nil {
+				return fmt.Errorf("starting %s: %+v", id, err)
+			}
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting on start %s: %+v", id, err)
+			}
+		}
+	} else if len(blackoutWindows) > 0 {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
 		if err != nil {
-			return fmt.Errorf("starting %s: %+v", id, err)
+			return fmt.Errorf("retrieving %s to determine its current runtime state: %+v", id, err)
+		}
+		currentlyStarted := false
+		if existingProps, ok := existing.Properties.AsScheduleTrigger(); ok && existingProps != nil {
+			currentlyStarted = existingProps.RuntimeState == datafactory.TriggerRuntimeStateStarted
 		}
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting on start %s: %+v", id, err)
+
+		if currentlyStarted {
+			future, err := client.Stop(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+			if err != nil {
+				return fmt.Errorf("stopping %s: %+v", id, err)
+			}
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting to stop %s: %+v", id, err)
+			}
 		}
 	}
 
 	d.SetId(id.ID())
 
+	if next := nextDataFactoryBlackoutWindowTransition(blackoutWindows, time.Now()); next != nil {
+		d.Set("next_blackout_transition", next.Format(time.RFC3339))
+	} else {
+		d.Set("next_blackout_transition", "")
+	}
+
 	return resourceDataFactoryTriggerScheduleRead(d, meta)
 }
 
@@ -360,11 +560,22 @@ func resourceDataFactoryTriggerScheduleRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("activated", scheduleTriggerProps.RuntimeState == datafactory.TriggerRuntimeStateStarted)
 
 		if recurrence := scheduleTriggerProps.Recurrence; recurrence != nil {
+			timeZone := "UTC"
+			if recurrence.TimeZone != nil && *recurrence.TimeZone != "" {
+				timeZone = *recurrence.TimeZone
+			}
+			d.Set("time_zone", timeZone)
+
+			loc, err := time.LoadLocation(timeZone)
+			if err != nil {
+				return fmt.Errorf("parsing `time_zone` %q: %+v", timeZone, err)
+			}
+
 			if v := recurrence.StartTime; v != nil {
-				d.Set("start_time", v.Format(time.RFC3339))
+				d.Set("start_time", v.In(loc).Format(time.RFC3339))
 			}
 			if v := recurrence.EndTime; v != nil {
-				d.Set("end_time", v.Format(time.RFC3339))
+				d.Set("end_time", v.In(loc).Format(time.RFC3339))
 			}
 			d.Set("frequency", recurrence.Frequency)
 			d.Set("interval", recurrence.Interval)
@@ -374,14 +585,16 @@ func resourceDataFactoryTriggerScheduleRead(d *pluginsdk.ResourceData, meta inte
 			}
 		}
 
-		if pipelines := scheduleTriggerProps.Pipelines; pipelines != nil {
-			if len(*pipelines) > 0 {
-				pipeline := *pipelines
-				if reference := pipeline[0].PipelineReference; reference != nil {
-					d.Set("pipeline_name", reference.ReferenceName)
-				}
-				d.Set("pipeline_parameters", pipeline[0].Parameters)
-			}
+		pipelines := flattenDataFactoryTriggerSchedulePipelines(scheduleTriggerProps.Pipelines)
+		if err := d.Set("pipeline", pipelines); err != nil {
+			return fmt.Errorf("setting `pipeline`: %+v", err)
+		}
+
+		// TODO remove in 3.0
+		if len(pipelines) > 0 {
+			first := pipelines[0].(map[string]interface{})
+			d.Set("pipeline_name", first["name"])
+			d.Set("pipeline_parameters", first["parameters"])
 		}
 
 		annotations := flattenDataFactoryAnnotations(scheduleTriggerProps.Annotations)
@@ -420,6 +633,58 @@ func resourceDataFactoryTriggerScheduleDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
+// expandDataFactoryTriggerSchedulePipelines builds the `Pipelines` list from the repeatable
+// `pipeline` block, falling back to the deprecated single `pipeline_name`/`pipeline_parameters`
+// pair when no `pipeline` blocks are configured.
+func expandDataFactoryTriggerSchedulePipelines(d *pluginsdk.ResourceData) *[]datafactory.TriggerPipelineReference {
+	if v, ok := d.GetOk("pipeline"); ok {
+		input := v.([]interface{})
+		pipelines := make([]datafactory.TriggerPipelineReference, 0, len(input))
+		for _, p := range input {
+			value := p.(map[string]interface{})
+			pipelines = append(pipelines, datafactory.TriggerPipelineReference{
+				PipelineReference: &datafactory.PipelineReference{
+					ReferenceName: utils.String(value["name"].(string)),
+					Type:          utils.String("PipelineReference"),
+				},
+				Parameters: value["parameters"].(map[string]interface{}),
+			})
+		}
+		return &pipelines
+	}
+
+	return &[]datafactory.TriggerPipelineReference{
+		{
+			PipelineReference: &datafactory.PipelineReference{
+				ReferenceName: utils.String(d.Get("pipeline_name").(string)),
+				Type:          utils.String("PipelineReference"),
+			},
+			Parameters: d.Get("pipeline_parameters").(map[string]interface{}),
+		},
+	}
+}
+
+// flattenDataFactoryTriggerSchedulePipelines flattens `Pipelines` into the `pipeline` block.
+// `pipeline` is an order-sensitive TypeList, so the API's own ordering is preserved as-is rather
+// than re-sorted - the API already returns `Pipelines` in the order it was submitted.
+func flattenDataFactoryTriggerSchedulePipelines(input *[]datafactory.TriggerPipelineReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0, len(*input))
+	for _, v := range *input {
+		value := make(map[string]interface{})
+		if v.PipelineReference != nil && v.PipelineReference.ReferenceName != nil {
+			value["name"] = *v.PipelineReference.ReferenceName
+		}
+		value["parameters"] = v.Parameters
+		output = append(output, value)
+	}
+
+	return output
+}
+
 func expandDataFactorySchedule(input []interface{}) *datafactory.RecurrenceSchedule {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -0,0 +1,365 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceDataFactoryTriggerScheduleRun backfills a schedule trigger's pipeline over a historical
+// window, borrowing the "backfill" concept from Temporal's schedule API: it fabricates the trigger
+// instants the schedule would have fired at between `start_time` and `end_time` and issues a
+// pipeline run for each one.
+func resourceDataFactoryTriggerScheduleRun() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryTriggerScheduleRunCreateUpdate,
+		Read:   resourceDataFactoryTriggerScheduleRunRead,
+		Update: resourceDataFactoryTriggerScheduleRunCreateUpdate,
+		Delete: resourceDataFactoryTriggerScheduleRunDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"trigger_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+			},
+
+			"start_time": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"end_time": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			// `skip` issues no run for an instant that already has a run ID in `run_ids`, and
+			// otherwise issues runs one at a time like `buffer_one`. `buffer_one` waits for each
+			// CreateRun call to return before making the next; the pipeline runs it starts can
+			// still overlap. `allow_all` makes every instant's CreateRun call concurrently. A
+			// failed apply does not record the run IDs issued before the failure.
+			"overlap_policy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "skip",
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"skip",
+					"buffer_one",
+					"allow_all",
+				}, false),
+			},
+
+			"run_ids": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryTriggerScheduleRunCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	triggersClient := meta.(*clients.Client).DataFactory.TriggersClient
+	pipelinesClient := meta.(*clients.Client).DataFactory.PipelinesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	triggerName := d.Get("trigger_name").(string)
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string)) // should be validated by the schema
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))     // should be validated by the schema
+	if !endTime.After(startTime) {
+		return fmt.Errorf("`end_time` must be after `start_time`")
+	}
+
+	trigger, err := triggersClient.Get(ctx, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, triggerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Trigger %q (Data Factory %q / Resource Group %q): %+v", triggerName, dataFactoryId.FactoryName, dataFactoryId.ResourceGroup, err)
+	}
+
+	scheduleTriggerProps, ok := trigger.Properties.AsScheduleTrigger()
+	if !ok || scheduleTriggerProps == nil || scheduleTriggerProps.Recurrence == nil {
+		return fmt.Errorf("Trigger %q is not a schedule trigger", triggerName)
+	}
+	if scheduleTriggerProps.Pipelines == nil || len(*scheduleTriggerProps.Pipelines) == 0 {
+		return fmt.Errorf("Trigger %q has no associated pipeline", triggerName)
+	}
+	pipelineReference := (*scheduleTriggerProps.Pipelines)[0]
+	if pipelineReference.PipelineReference == nil || pipelineReference.PipelineReference.ReferenceName == nil {
+		return fmt.Errorf("Trigger %q has no associated pipeline", triggerName)
+	}
+	pipelineName := *pipelineReference.PipelineReference.ReferenceName
+
+	instants, err := computeScheduleTriggerWindowInstants(scheduleTriggerProps.Recurrence, startTime, endTime)
+	if err != nil {
+		return fmt.Errorf("computing backfill instants for Trigger %q: %+v", triggerName, err)
+	}
+
+	parameters := make(map[string]interface{})
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		parameters[k] = v
+	}
+
+	runIds := map[string]interface{}{}
+	if !d.IsNewResource() {
+		runIds = d.Get("run_ids").(map[string]interface{})
+	}
+
+	overlapPolicy := d.Get("overlap_policy").(string)
+
+	pending := make([]time.Time, 0, len(instants))
+	for _, instant := range instants {
+		key := instant.Format(time.RFC3339)
+		if overlapPolicy == "skip" {
+			if existing, ok := runIds[key]; ok && existing.(string) != "" {
+				continue
+			}
+		}
+		pending = append(pending, instant)
+	}
+
+	backfillOne := func(instant time.Time) (string, error) {
+		key := instant.Format(time.RFC3339)
+
+		instantParameters := make(map[string]interface{}, len(parameters)+1)
+		for k, v := range parameters {
+			instantParameters[k] = v
+		}
+		if _, ok := instantParameters["windowStart"]; !ok {
+			instantParameters["windowStart"] = key
+		}
+
+		createRun, err := pipelinesClient.CreateRun(ctx, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, pipelineName, nil, nil, nil, instantParameters)
+		if err != nil {
+			return "", fmt.Errorf("backfilling Trigger %q at %s: %+v", triggerName, key, err)
+		}
+		if createRun.RunID == nil {
+			return "", nil
+		}
+		return *createRun.RunID, nil
+	}
+
+	if overlapPolicy == "allow_all" {
+		// Fire every pending instant's run concurrently rather than waiting on the previous one.
+		type result struct {
+			key   string
+			runId string
+			err   error
+		}
+		results := make(chan result, len(pending))
+		for _, instant := range pending {
+			go func(instant time.Time) {
+				runId, err := backfillOne(instant)
+				results <- result{key: instant.Format(time.RFC3339), runId: runId, err: err}
+			}(instant)
+		}
+		for range pending {
+			r := <-results
+			if r.err != nil {
+				return r.err
+			}
+			if r.runId != "" {
+				runIds[r.key] = r.runId
+			}
+		}
+	} else {
+		// `skip` and `buffer_one` both wait for each run's CreateRun call to return before
+		// issuing the next, so they never have more than one CreateRun call in flight at once;
+		// the pipeline runs those calls start are not waited on and can overlap.
+		for _, instant := range pending {
+			key := instant.Format(time.RFC3339)
+			runId, err := backfillOne(instant)
+			if err != nil {
+				return err
+			}
+			if runId != "" {
+				runIds[key] = runId
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/triggers/%s/backfills/%s-%s", dataFactoryId.ID(), triggerName, d.Get("start_time").(string), d.Get("end_time").(string)))
+
+	if err := d.Set("run_ids", runIds); err != nil {
+		return fmt.Errorf("setting `run_ids`: %+v", err)
+	}
+
+	return resourceDataFactoryTriggerScheduleRunRead(d, meta)
+}
+
+func resourceDataFactoryTriggerScheduleRunRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	triggersClient := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	triggerName := d.Get("trigger_name").(string)
+
+	resp, err := triggersClient.Get(ctx, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, triggerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			log.Printf("[DEBUG] Data Factory Trigger %q was not found in Resource Group %q - removing backfill from state!", triggerName, dataFactoryId.ResourceGroup)
+			return nil
+		}
+		return fmt.Errorf("retrieving Trigger %q (Data Factory %q / Resource Group %q): %+v", triggerName, dataFactoryId.FactoryName, dataFactoryId.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func resourceDataFactoryTriggerScheduleRunDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	// Backfilled pipeline runs are historical records - there's nothing in the API to delete, so
+	// removing this resource only drops it from state.
+	return nil
+}
+
+// computeScheduleTriggerWindowInstants deterministically expands a schedule trigger's recurrence
+// into the list of instants it would have fired at between start and end (both inclusive), so
+// that re-applying the same window is always a no-op.
+func computeScheduleTriggerWindowInstants(recurrence *datafactory.ScheduleTriggerRecurrence, start, end time.Time) ([]time.Time, error) {
+	if recurrence == nil {
+		return nil, fmt.Errorf("trigger has no recurrence")
+	}
+
+	interval := 1
+	if recurrence.Interval != nil {
+		interval = int(*recurrence.Interval)
+	}
+	if interval < 1 {
+		interval = 1
+	}
+
+	advance := func(t time.Time) (time.Time, error) {
+		switch recurrence.Frequency {
+		case datafactory.RecurrenceFrequencyMinute:
+			return t.Add(time.Duration(interval) * time.Minute), nil
+		case datafactory.RecurrenceFrequencyHour:
+			return t.Add(time.Duration(interval) * time.Hour), nil
+		case datafactory.RecurrenceFrequencyDay:
+			return t.AddDate(0, 0, interval), nil
+		case datafactory.RecurrenceFrequencyWeek:
+			return t.AddDate(0, 0, 7*interval), nil
+		case datafactory.RecurrenceFrequencyMonth:
+			return t.AddDate(0, interval, 0), nil
+		default:
+			return t, fmt.Errorf("unsupported recurrence frequency %q", recurrence.Frequency)
+		}
+	}
+
+	// When the trigger's own start time is before the window, walk forward from it rather than
+	// from an arbitrary offset into the window, so the fabricated cadence lines up with what the
+	// service would actually have produced. Otherwise the walk starts at the window's `start`.
+	cursor := start
+	if recurrence.StartTime != nil && recurrence.StartTime.Time.Before(start) {
+		cursor = recurrence.StartTime.Time
+		for cursor.Before(start) {
+			next, err := advance(cursor)
+			if err != nil {
+				return nil, err
+			}
+			cursor = next
+		}
+	}
+
+	instants := make([]time.Time, 0)
+	for !cursor.After(end) {
+		if !cursor.Before(start) && scheduleTriggerInstantMatches(recurrence.Schedule, cursor) {
+			instants = append(instants, cursor)
+		}
+		next, err := advance(cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = next
+	}
+
+	return instants, nil
+}
+
+func scheduleTriggerInstantMatches(schedule *datafactory.RecurrenceSchedule, t time.Time) bool {
+	if schedule == nil {
+		return true
+	}
+	if schedule.Minutes != nil && !int32SliceContainsValue(*schedule.Minutes, int32(t.Minute())) {
+		return false
+	}
+	if schedule.Hours != nil && !int32SliceContainsValue(*schedule.Hours, int32(t.Hour())) {
+		return false
+	}
+	if schedule.MonthDays != nil && !int32SliceContainsValue(*schedule.MonthDays, int32(t.Day())) {
+		return false
+	}
+	if schedule.WeekDays != nil && !daysOfWeekSliceContainsValue(*schedule.WeekDays, t.Weekday()) {
+		return false
+	}
+	return true
+}
+
+func int32SliceContainsValue(haystack []int32, needle int32) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func daysOfWeekSliceContainsValue(haystack []datafactory.DaysOfWeek, needle time.Weekday) bool {
+	for _, v := range haystack {
+		if string(v) == needle.String() {
+			return true
+		}
+	}
+	return false
+}
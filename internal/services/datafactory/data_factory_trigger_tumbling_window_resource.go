@@ -0,0 +1,473 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryTriggerTumblingWindow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryTriggerTumblingWindowCreateUpdate,
+		Read:   resourceDataFactoryTriggerTumblingWindowRead,
+		Update: resourceDataFactoryTriggerTumblingWindowCreateUpdate,
+		Delete: resourceDataFactoryTriggerTumblingWindowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.TriggerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+			},
+
+			// Derived from `data_factory_id` rather than taken as input - this resource has no
+			// legacy `data_factory_name`/`resource_group_name` pair to support, unlike the older
+			// schedule trigger, so there's nothing for a user-supplied value to pair against.
+			"resource_group_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"frequency": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(datafactory.TumblingWindowFrequencyMinute),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.TumblingWindowFrequencyMinute),
+					string(datafactory.TumblingWindowFrequencyHour),
+					string(datafactory.TumblingWindowFrequencyMonth),
+				}, false),
+			},
+
+			"interval": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"start_time": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"end_time": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"delay": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"max_concurrency": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"retry_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"trigger_dependency": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// When unset, this entry is a self-dependency on a prior window of the
+						// same trigger; when set, it's a dependency on another tumbling window trigger.
+						"trigger_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+						},
+
+						"offset": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"size": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"activated": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pipeline_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+			},
+
+			"pipeline_parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryTriggerTumblingWindowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewTriggerID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_trigger_tumbling_window", *existing.ID)
+		}
+	}
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string)) // should be validated by the schema
+
+	props := &datafactory.TumblingWindowTriggerTypeProperties{
+		Frequency:      datafactory.TumblingWindowFrequency(d.Get("frequency").(string)),
+		Interval:       utils.Int32(int32(d.Get("interval").(int))),
+		StartTime:      &date.Time{Time: startTime},
+		MaxConcurrency: utils.Int32(int32(d.Get("max_concurrency").(int))),
+		Pipeline: &datafactory.PipelineReference{
+			ReferenceName: utils.String(d.Get("pipeline_name").(string)),
+			Type:          utils.String("PipelineReference"),
+		},
+		Parameters: d.Get("pipeline_parameters").(map[string]interface{}),
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string)) // should be validated by the schema
+		props.EndTime = &date.Time{Time: t}
+	}
+
+	if v, ok := d.GetOk("delay"); ok {
+		props.Delay = v.(string)
+	}
+
+	if v, ok := d.GetOk("retry_policy"); ok {
+		props.RetryPolicy = expandDataFactoryTumblingWindowRetryPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("trigger_dependency"); ok {
+		props.DependsOn = expandDataFactoryTumblingWindowDependsOn(v.([]interface{}))
+	}
+
+	tumblingWindowProps := &datafactory.TumblingWindowTrigger{
+		TumblingWindowTriggerTypeProperties: props,
+		Description:                         utils.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		tumblingWindowProps.Annotations = &annotations
+	}
+
+	trigger := datafactory.TriggerResource{
+		Properties: tumblingWindowProps,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, trigger, ""); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if d.Get("activated").(bool) {
+		future, err := client.Start(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+		if err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on start %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryTriggerTumblingWindowRead(d, meta)
+}
+
+func resourceDataFactoryTriggerTumblingWindowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataFactoryId := parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			log.Printf("[DEBUG] Data Factory Trigger Tumbling Window %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_id", dataFactoryId.ID())
+
+	tumblingWindowProps, ok := resp.Properties.AsTumblingWindowTrigger()
+	if !ok {
+		return fmt.Errorf("classifying Data Factory %s: Expected: %q Received: %q", *id, datafactory.TypeBasicTriggerTypeTumblingWindowTrigger, *resp.Type)
+	}
+
+	if tumblingWindowProps != nil {
+		d.Set("activated", tumblingWindowProps.RuntimeState == datafactory.TriggerRuntimeStateStarted)
+		d.Set("description", tumblingWindowProps.Description)
+		d.Set("frequency", tumblingWindowProps.Frequency)
+		d.Set("interval", tumblingWindowProps.Interval)
+		d.Set("max_concurrency", tumblingWindowProps.MaxConcurrency)
+		d.Set("delay", tumblingWindowProps.Delay)
+
+		if v := tumblingWindowProps.StartTime; v != nil {
+			d.Set("start_time", v.Format(time.RFC3339))
+		}
+		if v := tumblingWindowProps.EndTime; v != nil {
+			d.Set("end_time", v.Format(time.RFC3339))
+		}
+
+		if err := d.Set("retry_policy", flattenDataFactoryTumblingWindowRetryPolicy(tumblingWindowProps.RetryPolicy)); err != nil {
+			return fmt.Errorf("setting `retry_policy`: %+v", err)
+		}
+
+		if err := d.Set("trigger_dependency", flattenDataFactoryTumblingWindowDependsOn(tumblingWindowProps.DependsOn)); err != nil {
+			return fmt.Errorf("setting `trigger_dependency`: %+v", err)
+		}
+
+		if reference := tumblingWindowProps.Pipeline; reference != nil {
+			d.Set("pipeline_name", reference.ReferenceName)
+		}
+		d.Set("pipeline_parameters", tumblingWindowProps.Parameters)
+
+		annotations := flattenDataFactoryAnnotations(tumblingWindowProps.Annotations)
+		if err := d.Set("annotations", annotations); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryTriggerTumblingWindowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Stop(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to stop %s: %+v", id, err)
+	}
+
+	if _, err = client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDataFactoryTumblingWindowRetryPolicy(input []interface{}) *datafactory.RetryPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	value := input[0].(map[string]interface{})
+	policy := &datafactory.RetryPolicy{}
+	if v, ok := value["count"].(int); ok && v > 0 {
+		policy.Count = utils.Int32(int32(v))
+	}
+	if v, ok := value["interval_in_seconds"].(int); ok && v > 0 {
+		policy.IntervalInSeconds = utils.Int32(int32(v))
+	}
+
+	return policy
+}
+
+func flattenDataFactoryTumblingWindowRetryPolicy(input *datafactory.RetryPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	value := make(map[string]interface{})
+	if input.Count != nil {
+		value["count"] = *input.Count
+	}
+	if input.IntervalInSeconds != nil {
+		value["interval_in_seconds"] = *input.IntervalInSeconds
+	}
+
+	return []interface{}{value}
+}
+
+func expandDataFactoryTumblingWindowDependsOn(input []interface{}) *[]datafactory.BasicDependencyReference {
+	dependsOn := make([]datafactory.BasicDependencyReference, 0)
+
+	for _, v := range input {
+		value := v.(map[string]interface{})
+		offset := value["offset"].(string)
+		size := value["size"].(string)
+
+		if triggerName, ok := value["trigger_name"].(string); ok && triggerName != "" {
+			dependsOn = append(dependsOn, datafactory.TumblingWindowTriggerDependencyReference{
+				ReferenceTrigger: &datafactory.TriggerReference{
+					ReferenceName: utils.String(triggerName),
+					Type:          utils.String("TriggerReference"),
+				},
+				Offset: utils.String(offset),
+				Size:   utils.String(size),
+			})
+			continue
+		}
+
+		dependsOn = append(dependsOn, datafactory.SelfDependencyTumblingWindowTriggerReference{
+			Offset: utils.String(offset),
+			Size:   utils.String(size),
+		})
+	}
+
+	return &dependsOn
+}
+
+func flattenDataFactoryTumblingWindowDependsOn(input *[]datafactory.BasicDependencyReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		value := make(map[string]interface{})
+
+		if self, ok := v.AsSelfDependencyTumblingWindowTriggerReference(); ok {
+			if self.Offset != nil {
+				value["offset"] = *self.Offset
+			}
+			if self.Size != nil {
+				value["size"] = *self.Size
+			}
+		}
+
+		if cross, ok := v.AsTumblingWindowTriggerDependencyReference(); ok {
+			if cross.ReferenceTrigger != nil && cross.ReferenceTrigger.ReferenceName != nil {
+				value["trigger_name"] = *cross.ReferenceTrigger.ReferenceName
+			}
+			if cross.Offset != nil {
+				value["offset"] = *cross.Offset
+			}
+			if cross.Size != nil {
+				value["size"] = *cross.Size
+			}
+		}
+
+		output = append(output, value)
+	}
+
+	return output
+}